@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountResolverResource{}
+
+func NewAccountResolverResource() resource.Resource {
+	return &AccountResolverResource{}
+}
+
+// AccountResolverResource defines the resource implementation.
+type AccountResolverResource struct {
+}
+
+// AccountResolverResourceModel describes the resource data model.
+type AccountResolverResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ServerURL          types.String `tfsdk:"server_url"`
+	SystemAccountCreds types.String `tfsdk:"system_account_creds"`
+	AccountJwt         types.String `tfsdk:"account_jwt"`
+	Mode               types.String `tfsdk:"mode"`
+	DirPath            types.String `tfsdk:"dir_path"`
+	CaFile             types.String `tfsdk:"ca_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClaimHash          types.String `tfsdk:"claim_hash"`
+}
+
+func (r *AccountResolverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_resolver"
+}
+
+func (r *AccountResolverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "将 account JWT 推送到运行中的 nats-server",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID",
+			},
+			"server_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "nats-server 地址，如 nats://localhost:4222",
+			},
+			"system_account_creds": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "系统账户的 creds 文件内容，用于连接并签发 CLAIMS.DELETE 请求",
+			},
+			"account_jwt": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "要推送的 account JWT",
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "推送方式，push（默认，发布到 $SYS.REQ.CLAIMS.UPDATE）或 dir（写入目录解析器路径）",
+			},
+			"dir_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "mode = dir 时，JWT 写入的目录解析器路径",
+			},
+			"ca_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "校验 server_url TLS 证书的 CA 文件",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "跳过 server_url 的 TLS 证书校验",
+			},
+			"claim_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "服务端返回的 claim hash；mode = dir 或服务端未返回时为 account 公钥",
+			},
+		},
+	}
+}
+
+func (r *AccountResolverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+}
+
+// claimsUpdateResponse 对应 $SYS.REQ.CLAIMS.UPDATE 的响应
+type claimsUpdateResponse struct {
+	Data  string `json:"data"`
+	Error *struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error"`
+}
+
+func accountResolverConnect(data *AccountResolverResourceModel) (*nats.Conn, error) {
+	credsFile, err := os.CreateTemp("", "nats-account-resolver-*.creds")
+	if err != nil {
+		return nil, fmt.Errorf("system_account_creds 写入失败: %w", err)
+	}
+	defer os.Remove(credsFile.Name())
+
+	if _, err := credsFile.WriteString(data.SystemAccountCreds.ValueString()); err != nil {
+		credsFile.Close()
+		return nil, fmt.Errorf("system_account_creds 写入失败: %w", err)
+	}
+	if err := credsFile.Close(); err != nil {
+		return nil, fmt.Errorf("system_account_creds 写入失败: %w", err)
+	}
+
+	opts := []nats.Option{nats.UserCredentials(credsFile.Name())}
+
+	if !data.CaFile.IsNull() || data.InsecureSkipVerify.ValueBool() {
+		tlsConfig := &tls.Config{}
+
+		if !data.CaFile.IsNull() {
+			pem, err := os.ReadFile(data.CaFile.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("ca_file 读取失败: %w", err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("ca_file 不是有效的 PEM 证书")
+			}
+			tlsConfig.RootCAs = pool
+		}
+		if data.InsecureSkipVerify.ValueBool() {
+			tlsConfig.InsecureSkipVerify = true
+		}
+
+		opts = append(opts, nats.Secure(tlsConfig))
+	}
+
+	conn, err := nats.Connect(data.ServerURL.ValueString(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("连接 nats-server 失败: %w", err)
+	}
+	return conn, nil
+}
+
+func pushAccountJWT(data *AccountResolverResourceModel) error {
+	accountPublicKey, err := accountJWTSubject(data.AccountJwt.ValueString())
+	if err != nil {
+		return err
+	}
+
+	if data.Mode.ValueString() == "dir" {
+		if data.DirPath.IsNull() {
+			return fmt.Errorf("mode = dir 时 dir_path 必填")
+		}
+		dest := filepath.Join(data.DirPath.ValueString(), accountPublicKey+".jwt")
+		if err := os.WriteFile(dest, []byte(data.AccountJwt.ValueString()), 0o644); err != nil {
+			return fmt.Errorf("写入目录解析器失败: %w", err)
+		}
+		data.ClaimHash = types.StringValue(accountPublicKey)
+		data.ID = types.StringValue(accountPublicKey)
+		return nil
+	}
+
+	conn, err := accountResolverConnect(data)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	msg, err := conn.Request("$SYS.REQ.CLAIMS.UPDATE", []byte(data.AccountJwt.ValueString()), 5*time.Second)
+	if err != nil {
+		return fmt.Errorf("推送 account JWT 失败: %w", err)
+	}
+
+	var reply claimsUpdateResponse
+	if err := json.Unmarshal(msg.Data, &reply); err != nil {
+		return fmt.Errorf("解析 nats-server 响应失败: %w", err)
+	}
+	if reply.Error != nil {
+		return fmt.Errorf("nats-server 拒绝了 account JWT: %s", reply.Error.Description)
+	}
+
+	claimHash := reply.Data
+	if claimHash == "" {
+		claimHash = accountPublicKey
+	}
+	data.ClaimHash = types.StringValue(claimHash)
+	data.ID = types.StringValue(claimHash)
+	return nil
+}
+
+// accountJWTSubject 返回 JWT 签发对象的 account 公钥
+func accountJWTSubject(token string) (string, error) {
+	claims, err := jwt.DecodeGeneric(token)
+	if err != nil {
+		return "", fmt.Errorf("account_jwt 错误: %w", err)
+	}
+	if !nkeys.IsValidPublicAccountKey(claims.Subject) {
+		return "", fmt.Errorf("account_jwt sub 不是合法的 Account 公钥")
+	}
+	return claims.Subject, nil
+}
+
+func (r *AccountResolverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccountResolverResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := pushAccountJWT(&data); err != nil {
+		resp.Diagnostics.AddError("推送 account JWT 错误", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountResolverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccountResolverResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountResolverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccountResolverResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := pushAccountJWT(&data); err != nil {
+		resp.Diagnostics.AddError("推送 account JWT 错误", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountResolverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccountResolverResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Mode.ValueString() == "dir" {
+		accountPublicKey, err := accountJWTSubject(data.AccountJwt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("删除 account JWT 错误", err.Error())
+			return
+		}
+		dest := filepath.Join(data.DirPath.ValueString(), accountPublicKey+".jwt")
+		if err := os.Remove(dest); err != nil && !os.IsNotExist(err) {
+			resp.Diagnostics.AddError("删除 account JWT 错误", err.Error())
+		}
+		return
+	}
+
+	conn, err := accountResolverConnect(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("删除 account JWT 错误", err.Error())
+		return
+	}
+	defer conn.Close()
+
+	accountPublicKey, err := accountJWTSubject(data.AccountJwt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("删除 account JWT 错误", err.Error())
+		return
+	}
+
+	// 删除请求需由系统账户签名
+	sysUser, err := systemUserFromCreds(data.SystemAccountCreds.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("删除 account JWT 错误", err.Error())
+		return
+	}
+
+	deleteClaims := jwt.NewGenericClaims(accountPublicKey)
+	deleteClaims.Data["accounts"] = []string{accountPublicKey}
+	deleteRequest, err := deleteClaims.Encode(sysUser)
+	if err != nil {
+		resp.Diagnostics.AddError("删除 account JWT 错误", err.Error())
+		return
+	}
+
+	msg, err := conn.Request("$SYS.REQ.CLAIMS.DELETE", []byte(deleteRequest), 5*time.Second)
+	if err != nil {
+		resp.Diagnostics.AddError("删除 account JWT 错误", err.Error())
+		return
+	}
+
+	var reply claimsUpdateResponse
+	if err := json.Unmarshal(msg.Data, &reply); err != nil {
+		resp.Diagnostics.AddError("删除 account JWT 错误", err.Error())
+		return
+	}
+	if reply.Error != nil {
+		resp.Diagnostics.AddError("删除 account JWT 错误", reply.Error.Description)
+	}
+}
+
+// systemUserFromCreds 从系统账户的 creds 文件中提取 NKey Seed
+func systemUserFromCreds(creds string) (nkeys.KeyPair, error) {
+	seed, err := jwt.ParseDecoratedNKey([]byte(creds))
+	if err != nil {
+		return nil, fmt.Errorf("system_account_creds 中缺少 NKey Seed: %w", err)
+	}
+	return seed, nil
+}
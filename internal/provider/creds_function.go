@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var (
+	_ function.Function = CredsFunction{}
+)
+
+func NewCredsFunction() function.Function {
+	return CredsFunction{}
+}
+
+type CredsFunction struct{}
+
+func (r CredsFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "creds"
+}
+
+func (r CredsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Creds function",
+		MarkdownDescription: "生成 NATS creds 文件内容",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "jwt",
+				MarkdownDescription: "用户 JWT",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "seed",
+				MarkdownDescription: "用户 NKey Seed",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (r CredsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token, seed string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &token, &seed))
+	if resp.Error != nil {
+		return
+	}
+
+	creds, err := formatCreds(token, seed)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, creds))
+}
+
+// credsTemplate 与 nsc 生成的 .creds 格式一致
+const credsTemplate = `-----BEGIN NATS USER JWT-----
+%s
+------END NATS USER JWT------
+
+************************* IMPORTANT *************************
+NKEY Seed printed below can be used to sign and prove identity.
+NKEYs are sensitive and should be treated as secrets.
+
+-----BEGIN USER NKEY SEED-----
+%s
+------END USER NKEY SEED------
+
+*************************************************************
+`
+
+// formatCreds 校验 jwt 的 sub 与 seed 公钥一致后渲染 .creds 文件
+func formatCreds(token, seed string) (string, error) {
+	claims, err := jwt.DecodeGeneric(token)
+	if err != nil {
+		return "", fmt.Errorf("jwt 错误: %w", err)
+	}
+
+	prefix, _, err := nkeys.DecodeSeed([]byte(seed))
+	if err != nil {
+		return "", fmt.Errorf("seed 错误: %w", err)
+	}
+	if prefix != nkeys.PrefixByteUser {
+		return "", fmt.Errorf("seed 类型错误: 需要 User seed")
+	}
+
+	user, err := nkeys.FromSeed([]byte(seed))
+	if err != nil {
+		return "", fmt.Errorf("seed 错误: %w", err)
+	}
+	publicKey, err := user.PublicKey()
+	if err != nil {
+		return "", fmt.Errorf("seed 错误: %w", err)
+	}
+
+	if claims.Subject != publicKey {
+		return "", fmt.Errorf("jwt sub 与 seed 公钥不匹配")
+	}
+
+	return fmt.Sprintf(credsTemplate, wrap76(token), wrap76(seed)), nil
+}
+
+// wrap76 每 76 列换行，与 .creds 文件的排版一致
+func wrap76(s string) string {
+	const width = 76
+
+	var b strings.Builder
+	for len(s) > width {
+		b.WriteString(s[:width])
+		b.WriteByte('\n')
+		s = s[width:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
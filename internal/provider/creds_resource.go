@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+//
+// CredsResource does not implement resource.ResourceWithImportState: jwt
+// and seed can't be recovered from id (a hash), so an ID-only import could
+// never populate them.
+var _ resource.Resource = &CredsResource{}
+
+func NewCredsResource() resource.Resource {
+	return &CredsResource{}
+}
+
+// CredsResource defines the resource implementation.
+type CredsResource struct {
+}
+
+// CredsResourceModel describes the resource data model.
+type CredsResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Jwt   types.String `tfsdk:"jwt"`
+	Seed  types.String `tfsdk:"seed"`
+	Creds types.String `tfsdk:"creds"`
+}
+
+func (r *CredsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_creds"
+}
+
+func (r *CredsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NATS creds 文件",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID",
+			},
+			"jwt": schema.StringAttribute{
+				Computed:            false,
+				MarkdownDescription: "User JWT",
+				Required:            true,
+			},
+			"seed": schema.StringAttribute{
+				Computed:            false,
+				MarkdownDescription: "User NKey Seed",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"creds": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Creds",
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *CredsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+}
+
+func UpdateCreds(data *CredsResourceModel) error {
+	creds, err := formatCreds(data.Jwt.ValueString(), data.Seed.ValueString())
+	if err != nil {
+		return err
+	}
+
+	id, err := hash(creds)
+	if err != nil {
+		return err
+	}
+
+	data.Creds = types.StringValue(creds)
+	data.ID = types.StringValue(id)
+	return nil
+}
+
+func (r *CredsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CredsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := UpdateCreds(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("生成 creds 错误", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CredsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := UpdateCreds(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("读取 creds 错误", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CredsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := UpdateCreds(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("更新 creds 错误", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CredsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
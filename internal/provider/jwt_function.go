@@ -177,6 +177,10 @@ func (r JwtFunction) Run(ctx context.Context, req function.RunRequest, resp *fun
 					resp.Error = function.NewFuncError("signing_key 类型错误")
 					return
 				}
+				// UserScope 内部也保存了签名公钥，需同步更新
+				if userScope, ok := scope.(*jwt.UserScope); ok {
+					userScope.Key = publicKey
+				}
 				signingKeys[publicKey] = scope
 			}
 			ocliams.Account.SigningKeys = signingKeys
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JwtVerifyDataSource{}
+
+func NewJwtVerifyDataSource() datasource.DataSource {
+	return &JwtVerifyDataSource{}
+}
+
+// JwtVerifyDataSource defines the data source implementation.
+type JwtVerifyDataSource struct {
+}
+
+// JwtVerifyDataSourceModel describes the data source data model.
+type JwtVerifyDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Token           types.String `tfsdk:"token"`
+	IssuerPublicKey types.String `tfsdk:"issuer_public_key"`
+	Issuer          types.String `tfsdk:"iss"`
+	Subject         types.String `tfsdk:"sub"`
+	Name            types.String `tfsdk:"name"`
+	Audience        types.String `tfsdk:"aud"`
+	IssuedAt        types.Int64  `tfsdk:"iat"`
+	Expires         types.Int64  `tfsdk:"exp"`
+	NotBefore       types.Int64  `tfsdk:"nbf"`
+	Nats            types.String `tfsdk:"nats"`
+}
+
+func (d *JwtVerifyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_verify"
+}
+
+func (d *JwtVerifyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "校验 NATS JWT 并解析声明",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID",
+			},
+			"token": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "待校验的 JWT",
+			},
+			"issuer_public_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "签发者公钥",
+			},
+			"iss": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Issuer",
+			},
+			"sub": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Subject",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name",
+			},
+			"aud": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Audience",
+			},
+			"iat": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "IssuedAt",
+			},
+			"exp": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Expires",
+			},
+			"nbf": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "NotBefore",
+			},
+			"nats": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Nats",
+			},
+		},
+	}
+}
+
+func (d *JwtVerifyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+}
+
+func (d *JwtVerifyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JwtVerifyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claims, err := verifyJWT(data.Token.ValueString(), data.IssuerPublicKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("校验 JWT 错误", err.Error())
+		return
+	}
+
+	nats, err := json.Marshal(claims.Data)
+	if err != nil {
+		resp.Diagnostics.AddError("校验 JWT 错误", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(claims.ID)
+	data.Issuer = types.StringValue(claims.Issuer)
+	data.Subject = types.StringValue(claims.Subject)
+	data.Name = types.StringValue(claims.Name)
+	data.Audience = types.StringValue(claims.Audience)
+	data.IssuedAt = types.Int64Value(claims.IssuedAt)
+	data.Expires = types.Int64Value(claims.Expires)
+	data.NotBefore = types.Int64Value(claims.NotBefore)
+	data.Nats = types.StringValue(string(nats))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
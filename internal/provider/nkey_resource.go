@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/nats-io/nkeys"
@@ -36,6 +38,9 @@ type NkeyResourceModel struct {
 	Subject types.String `tfsdk:"subject"`
 	Private types.String `tfsdk:"private"`
 	Public  types.String `tfsdk:"public"`
+
+	CurvePublic   types.String  `tfsdk:"curve_public"`
+	RotateTrigger types.Dynamic `tfsdk:"rotate_trigger"`
 }
 
 func (r *NkeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,7 +55,7 @@ func (r *NkeyResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 		Attributes: map[string]schema.Attribute{
 			"type": schema.StringAttribute{
 				Computed:            false,
-				MarkdownDescription: "类型(Operator, Account, User)",
+				MarkdownDescription: "类型(Operator, Account, User, Curve)",
 				Required:            true,
 			},
 			"id": schema.StringAttribute{
@@ -69,6 +74,17 @@ func (r *NkeyResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 				MarkdownDescription: "Public",
 			},
+			"curve_public": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Curve Public (仅 type = Curve 时有值)",
+			},
+			"rotate_trigger": schema.DynamicAttribute{
+				Optional:            true,
+				MarkdownDescription: "任意值，发生变化时强制重新生成 NKey",
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -89,9 +105,11 @@ func UpdateNKey(data *NkeyResourceModel) error {
 	if prefix == nkeys.PrefixByteOperator {
 		data.Type = types.StringValue("Operator")
 	} else if prefix == nkeys.PrefixByteAccount {
-		data.Type = types.StringValue("Operator")
+		data.Type = types.StringValue("Account")
 	} else if prefix == nkeys.PrefixByteUser {
 		data.Type = types.StringValue("User")
+	} else if prefix == nkeys.PrefixByteCurve {
+		data.Type = types.StringValue("Curve")
 	} else {
 		return function.NewFuncError("读取 NKey 错误")
 	}
@@ -107,12 +125,21 @@ func UpdateNKey(data *NkeyResourceModel) error {
 	}
 	data.Subject = types.StringValue(subject)
 
+	// XKey 使用 curve25519，没有对应的 ed25519 密钥对
+	if prefix == nkeys.PrefixByteCurve {
+		data.CurvePublic = types.StringValue(subject)
+		data.Public = types.StringValue("")
+		data.Private = types.StringValue("")
+		return nil
+	}
+
 	pub, priv, err := ed25519.GenerateKey(bytes.NewReader(rawSeed))
 	if err != nil {
 		return function.NewFuncError("读取 NKey 错误")
 	}
 	data.Public = types.StringValue(b64Enc.EncodeToString(pub))
 	data.Private = types.StringValue(b64Enc.EncodeToString(priv))
+	data.CurvePublic = types.StringValue("")
 
 	return nil
 }
@@ -133,6 +160,8 @@ func (r *NkeyResource) Create(ctx context.Context, req resource.CreateRequest, r
 		keys, err = nkeys.CreateAccount()
 	} else if data.Type.ValueString() == "User" {
 		keys, err = nkeys.CreateUser()
+	} else if data.Type.ValueString() == "Curve" {
+		keys, err = nkeys.CreatePair(nkeys.PrefixByteCurve)
 	} else {
 		resp.Diagnostics.AddError("生成 NKey", "类型错误")
 		return
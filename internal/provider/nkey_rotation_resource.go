@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nats-io/nkeys"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+//
+// 不实现 resource.ResourceWithImportState：id 是公钥，无法据此还原 seed。
+var _ resource.Resource = &NkeyRotationResource{}
+
+func NewNkeyRotationResource() resource.Resource {
+	return &NkeyRotationResource{}
+}
+
+// NkeyRotationResource defines the resource implementation.
+type NkeyRotationResource struct {
+}
+
+// NkeyRotationResourceModel describes the resource data model.
+type NkeyRotationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Seed              types.String `tfsdk:"seed"`
+	RetiredPublicKeys types.List   `tfsdk:"retired_public_keys"`
+	RetireAfter       types.Int64  `tfsdk:"retire_after"`
+	CurrentPublicKey  types.String `tfsdk:"current_public_key"`
+	ActivePublicKeys  types.List   `tfsdk:"active_public_keys"`
+}
+
+func (r *NkeyRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nkey_rotation"
+}
+
+func (r *NkeyRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NATS NKey 轮换，在宽限期内保留旧公钥供 signing_keys 过渡使用",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID",
+			},
+			"seed": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "当前使用的 NKey Seed，通常来自 nats_nkey.id",
+			},
+			"retired_public_keys": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "此前各次轮换时退出的公钥（逐次累积），过渡期内仍然有效；seed 变化时由 provider 自动补充",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"retire_after": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "retired_public_keys 失效的 Unix 时间戳，超过后下次 apply 会从 active_public_keys 中移除",
+			},
+			"current_public_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "当前 Seed 对应的公钥",
+			},
+			"active_public_keys": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "当前仍然有效的全部公钥，可直接喂给 account 的 signing_keys",
+			},
+		},
+	}
+}
+
+func (r *NkeyRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+}
+
+func UpdateNkeyRotation(data *NkeyRotationResourceModel) error {
+	keys, err := nkeys.FromSeed([]byte(data.Seed.ValueString()))
+	if err != nil {
+		return function.NewFuncError("seed 错误")
+	}
+	currentPublicKey, err := keys.PublicKey()
+	if err != nil {
+		return function.NewFuncError("seed 错误")
+	}
+	data.ID = types.StringValue(currentPublicKey)
+	data.CurrentPublicKey = types.StringValue(currentPublicKey)
+
+	var retired []string
+	if !data.RetiredPublicKeys.IsNull() && !data.RetiredPublicKeys.IsUnknown() {
+		if err := data.RetiredPublicKeys.ElementsAs(context.Background(), &retired, false); err != nil {
+			return function.NewFuncError("retired_public_keys 错误")
+		}
+	}
+
+	retired = pruneRetiredKeys(retired, data.RetireAfter)
+
+	active := append([]string{currentPublicKey}, retired...)
+	activeList, diags := types.ListValueFrom(context.Background(), types.StringType, active)
+	if diags.HasError() {
+		return function.NewFuncError("active_public_keys 生成错误")
+	}
+	data.ActivePublicKeys = activeList
+
+	return nil
+}
+
+// pruneRetiredKeys 在 retireAfter 到期后丢弃 retired
+func pruneRetiredKeys(retired []string, retireAfter types.Int64) []string {
+	if retireAfter.IsNull() || retireAfter.IsUnknown() {
+		return retired
+	}
+	if time.Now().UTC().Unix() >= retireAfter.ValueInt64() {
+		return nil
+	}
+	return retired
+}
+
+func (r *NkeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NkeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := UpdateNkeyRotation(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("生成 NKey 轮换错误", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NkeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NkeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := UpdateNkeyRotation(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("读取 NKey 轮换错误", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NkeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NkeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// seed 变化时，将旧的 current_public_key 并入 retired_public_keys
+	if plan.Seed.ValueString() != state.Seed.ValueString() && plan.RetiredPublicKeys.Equal(state.RetiredPublicKeys) {
+		var retired []string
+		if !state.RetiredPublicKeys.IsNull() {
+			resp.Diagnostics.Append(state.RetiredPublicKeys.ElementsAs(ctx, &retired, false)...)
+		}
+		retired = append(retired, state.CurrentPublicKey.ValueString())
+
+		retiredList, diags := types.ListValueFrom(ctx, types.StringType, retired)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.RetiredPublicKeys = retiredList
+	}
+
+	err := UpdateNkeyRotation(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("更新 NKey 轮换错误", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NkeyRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NkeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var (
+	_ function.Function = VerifyJwtFunction{}
+)
+
+func NewVerifyJwtFunction() function.Function {
+	return VerifyJwtFunction{}
+}
+
+type VerifyJwtFunction struct{}
+
+func (r VerifyJwtFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verify_jwt"
+}
+
+func (r VerifyJwtFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Verify jwt function",
+		MarkdownDescription: "校验 JWT 签名",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "token",
+				MarkdownDescription: "JWT",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "issuer_public_key",
+				MarkdownDescription: "签发者公钥",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (r VerifyJwtFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token, issuer string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &token, &issuer))
+	if resp.Error != nil {
+		return
+	}
+
+	_, err := verifyJWT(token, issuer)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, true))
+}
+
+// verifyJWT 校验 token 的签名并检查 exp/nbf 是否有效
+func verifyJWT(token, issuer string) (*jwt.GenericClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token 格式错误")
+	}
+
+	signed := parts[0] + "." + parts[1]
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("token 签名格式错误: %w", err)
+	}
+
+	key, err := nkeys.FromPublicKey(issuer)
+	if err != nil {
+		return nil, fmt.Errorf("issuer_public_key 错误: %w", err)
+	}
+	if err := key.Verify([]byte(signed), sig); err != nil {
+		return nil, fmt.Errorf("token 签名校验失败: %w", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("token payload 格式错误: %w", err)
+	}
+
+	var claims jwt.GenericClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("token payload 解析失败: %w", err)
+	}
+
+	now := time.Now().Unix()
+	if claims.Expires > 0 && claims.Expires < now {
+		return nil, fmt.Errorf("token 已过期")
+	}
+	if claims.NotBefore > 0 && claims.NotBefore > now {
+		return nil, fmt.Errorf("token 尚未生效")
+	}
+
+	return &claims, nil
+}